@@ -0,0 +1,33 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileobserver // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/fileobserver"
+
+import (
+	"errors"
+	"time"
+)
+
+// Config defines configuration for the file observer extension.
+type Config struct {
+	// WatchDirectories are the directories scanned for job files. Every
+	// `*.yaml`/`*.yml` file directly inside one is treated as a job file; the
+	// extension does not recurse into subdirectories.
+	WatchDirectories []string `mapstructure:"watch_directories"`
+
+	// RefreshInterval is how often directories are rescanned as a fallback
+	// for filesystems where fsnotify events aren't delivered (e.g. some
+	// network filesystems). Set to 0 to disable the fallback and rely solely
+	// on fsnotify.
+	RefreshInterval time.Duration `mapstructure:"refresh_interval"`
+}
+
+func (cfg *Config) Validate() error {
+	if len(cfg.WatchDirectories) == 0 {
+		return errors.New("watch_directories must specify at least one directory")
+	}
+	if cfg.RefreshInterval < 0 {
+		return errors.New("refresh_interval must not be negative")
+	}
+	return nil
+}
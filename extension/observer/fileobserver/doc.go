@@ -0,0 +1,11 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package fileobserver implements an observer extension that watches a set
+// of directories for YAML job files describing static targets, turning each
+// target into an observer.Endpoint. It fills the discovery gap on bare-metal
+// and VM hosts where no other observer extension applies: operators drop a
+// job file into a watched directory (e.g. /etc/otelcol/discovery.d/) to
+// start collection from a target without restarting the collector, and
+// remove the file to stop it.
+package fileobserver // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/fileobserver"
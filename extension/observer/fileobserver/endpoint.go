@@ -0,0 +1,52 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileobserver // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/fileobserver"
+
+import (
+	"fmt"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+)
+
+// fileEndpointType identifies endpoints synthesized from job files so
+// receivercreator rules can match them explicitly if needed, e.g.
+// `rule: type == "file_target"`.
+const fileEndpointType observer.EndpointType = "file_target"
+
+// fileEndpointDetails carries a job target's labels through as the
+// endpoint's env, the same shape rule evaluation uses for observer-sourced
+// endpoints' labels/annotations.
+type fileEndpointDetails struct {
+	Labels map[string]string
+}
+
+func (d fileEndpointDetails) Type() observer.EndpointType {
+	return fileEndpointType
+}
+
+func (d fileEndpointDetails) Env() observer.EndpointEnv {
+	labels := make(map[string]any, len(d.Labels))
+	for k, v := range d.Labels {
+		labels[k] = v
+	}
+	return observer.EndpointEnv{
+		"type":   string(fileEndpointType),
+		"labels": labels,
+	}
+}
+
+// endpointID derives a stable ID for a target from the file it came from and
+// its position within that file, so the same target re-read from the same
+// file produces the same ID across rescans.
+func endpointID(jobFilePath string, index int) observer.EndpointID {
+	return observer.EndpointID(fmt.Sprintf("%s/%d", jobFilePath, index))
+}
+
+func toEndpoint(jobFilePath string, index int, target jobTarget) observer.Endpoint {
+	return observer.Endpoint{
+		ID:      endpointID(jobFilePath, index),
+		Target:  target.Endpoint,
+		Details: fileEndpointDetails{Labels: target.Labels},
+	}
+}
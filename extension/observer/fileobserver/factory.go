@@ -0,0 +1,42 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileobserver // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/fileobserver"
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/extension"
+)
+
+// componentType is the type used to identify this extension in the
+// collector's configuration, e.g. `extensions: {file_observer: {...}}`.
+var componentType = component.MustNewType("file_observer")
+
+const defaultRefreshInterval = 30 * time.Second
+
+// NewFactory creates a factory for the file observer extension.
+func NewFactory() extension.Factory {
+	return extension.NewFactory(
+		componentType,
+		createDefaultConfig,
+		createExtension,
+		component.StabilityLevelAlpha,
+	)
+}
+
+func createDefaultConfig() component.Config {
+	return &Config{
+		RefreshInterval: defaultRefreshInterval,
+	}
+}
+
+func createExtension(
+	_ context.Context,
+	settings extension.Settings,
+	cfg component.Config,
+) (extension.Extension, error) {
+	return newObserver(settings.Logger, cfg.(*Config)), nil
+}
@@ -0,0 +1,40 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileobserver // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/fileobserver"
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// jobFile is the on-disk shape of a single discovery job file, e.g.:
+//
+//	targets:
+//	  - endpoint: "10.0.0.5:5432"
+//	    labels:
+//	      app: postgres
+//	      env: prod
+type jobFile struct {
+	Targets []jobTarget `yaml:"targets"`
+}
+
+type jobTarget struct {
+	Endpoint string            `yaml:"endpoint"`
+	Labels   map[string]string `yaml:"labels"`
+}
+
+// parseJobFile reads and parses a single job file from disk.
+func parseJobFile(path string) (jobFile, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return jobFile{}, fmt.Errorf("reading job file %q: %w", path, err)
+	}
+	var job jobFile
+	if err := yaml.Unmarshal(raw, &job); err != nil {
+		return jobFile{}, fmt.Errorf("parsing job file %q: %w", path, err)
+	}
+	return job, nil
+}
@@ -0,0 +1,26 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileobserver
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseJobFile(t *testing.T) {
+	job, err := parseJobFile(filepath.Join("testdata", "postgres.yaml"))
+	require.NoError(t, err)
+
+	require.Len(t, job.Targets, 1)
+	assert.Equal(t, "10.0.0.5:5432", job.Targets[0].Endpoint)
+	assert.Equal(t, map[string]string{"app": "postgres", "env": "prod"}, job.Targets[0].Labels)
+}
+
+func TestParseJobFile_NotFound(t *testing.T) {
+	_, err := parseJobFile(filepath.Join("testdata", "does-not-exist.yaml"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,271 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileobserver // import "github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer/fileobserver"
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"go.opentelemetry.io/collector/component"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+)
+
+// fileObserver watches Config.WatchDirectories for job files and turns their
+// targets into observer.Endpoints, notifying subscribers of adds/removes/
+// changes exactly like an endpoint-sourced observer would.
+type fileObserver struct {
+	logger *zap.Logger
+	cfg    *Config
+
+	watcher *fsnotify.Watcher
+	cancel  context.CancelFunc
+
+	mu        sync.Mutex
+	listeners map[observer.NotifyID]observer.Notify
+	// endpoints is keyed by job file path, since every endpoint it produced
+	// needs to be removed together if the file disappears.
+	endpoints map[string][]observer.Endpoint
+}
+
+func newObserver(logger *zap.Logger, cfg *Config) *fileObserver {
+	return &fileObserver{
+		logger:    logger,
+		cfg:       cfg,
+		listeners: map[observer.NotifyID]observer.Notify{},
+		endpoints: map[string][]observer.Endpoint{},
+	}
+}
+
+func (o *fileObserver) Start(_ context.Context, _ component.Host) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	o.watcher = watcher
+
+	for _, dir := range o.cfg.WatchDirectories {
+		if err := watcher.Add(dir); err != nil {
+			return err
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	o.cancel = cancel
+
+	o.rescanAll()
+	go o.run(ctx)
+	return nil
+}
+
+func (o *fileObserver) Shutdown(_ context.Context) error {
+	if o.cancel != nil {
+		o.cancel()
+	}
+	if o.watcher != nil {
+		return o.watcher.Close()
+	}
+	return nil
+}
+
+func (o *fileObserver) run(ctx context.Context) {
+	var tick <-chan time.Time
+	if o.cfg.RefreshInterval > 0 {
+		ticker := time.NewTicker(o.cfg.RefreshInterval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-o.watcher.Events:
+			if !ok {
+				return
+			}
+			if !isJobFile(event.Name) {
+				continue
+			}
+			switch {
+			case event.Op&fsnotify.Remove != 0 || event.Op&fsnotify.Rename != 0:
+				o.removeFile(event.Name)
+			default: // Create, Write, Chmod all just mean "re-read it".
+				o.rescanFile(event.Name)
+			}
+		case err, ok := <-o.watcher.Errors:
+			if !ok {
+				return
+			}
+			o.logger.Warn("file observer watch error", zap.Error(err))
+		case <-tick:
+			o.rescanAll()
+		}
+	}
+}
+
+func isJobFile(path string) bool {
+	ext := filepath.Ext(path)
+	return ext == ".yaml" || ext == ".yml"
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// ListAndWatch satisfies observer.Extension: it replays the current set of
+// endpoints to notify, then keeps it subscribed to future changes.
+func (o *fileObserver) ListAndWatch(notify observer.Notify) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.listeners[notify.ID()] = notify
+
+	var all []observer.Endpoint
+	for _, endpoints := range o.endpoints {
+		all = append(all, endpoints...)
+	}
+	if len(all) > 0 {
+		notify.OnAdd(all)
+	}
+}
+
+func (o *fileObserver) Unsubscribe(notify observer.Notify) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	delete(o.listeners, notify.ID())
+}
+
+// rescanAll re-reads every job file in every watched directory. Used for the
+// initial sync and as the periodic rescan fallback.
+func (o *fileObserver) rescanAll() {
+	for _, dir := range o.cfg.WatchDirectories {
+		entries, err := filepath.Glob(filepath.Join(dir, "*"))
+		if err != nil {
+			o.logger.Warn("failed to list watch directory", zap.String("dir", dir), zap.Error(err))
+			continue
+		}
+		for _, path := range entries {
+			if isJobFile(path) {
+				o.rescanFile(path)
+			}
+		}
+	}
+
+	o.mu.Lock()
+	known := make([]string, 0, len(o.endpoints))
+	for path := range o.endpoints {
+		known = append(known, path)
+	}
+	o.mu.Unlock()
+	for _, path := range known {
+		if !strings.HasSuffix(path, ".yaml") && !strings.HasSuffix(path, ".yml") {
+			continue
+		}
+		if !fileExists(path) {
+			o.removeFile(path)
+		}
+	}
+}
+
+// rescanFile re-reads a single job file and diffs its endpoints against what
+// was previously known for it, notifying only the delta.
+func (o *fileObserver) rescanFile(path string) {
+	job, err := parseJobFile(path)
+	if err != nil {
+		o.logger.Warn("failed to parse discovery job file", zap.String("file", path), zap.Error(err))
+		return
+	}
+
+	next := make([]observer.Endpoint, 0, len(job.Targets))
+	for i, target := range job.Targets {
+		next = append(next, toEndpoint(path, i, target))
+	}
+
+	o.mu.Lock()
+	prev := o.endpoints[path]
+	o.endpoints[path] = next
+	listeners := o.snapshotListeners()
+	o.mu.Unlock()
+
+	added, removed, changed := diffEndpoints(prev, next)
+	o.notify(listeners, added, removed, changed)
+}
+
+func (o *fileObserver) removeFile(path string) {
+	o.mu.Lock()
+	removed := o.endpoints[path]
+	delete(o.endpoints, path)
+	listeners := o.snapshotListeners()
+	o.mu.Unlock()
+
+	if len(removed) > 0 {
+		o.notify(listeners, nil, removed, nil)
+	}
+}
+
+func (o *fileObserver) snapshotListeners() []observer.Notify {
+	listeners := make([]observer.Notify, 0, len(o.listeners))
+	for _, l := range o.listeners {
+		listeners = append(listeners, l)
+	}
+	return listeners
+}
+
+func (o *fileObserver) notify(listeners []observer.Notify, added, removed, changed []observer.Endpoint) {
+	for _, l := range listeners {
+		if len(added) > 0 {
+			l.OnAdd(added)
+		}
+		if len(removed) > 0 {
+			l.OnRemove(removed)
+		}
+		if len(changed) > 0 {
+			l.OnChange(changed)
+		}
+	}
+}
+
+// diffEndpoints compares the previous and current endpoint sets for a single
+// job file by ID, since IDs are stable across rescans of the same file.
+func diffEndpoints(prev, next []observer.Endpoint) (added, removed, changed []observer.Endpoint) {
+	prevByID := make(map[observer.EndpointID]observer.Endpoint, len(prev))
+	for _, e := range prev {
+		prevByID[e.ID] = e
+	}
+	nextByID := make(map[observer.EndpointID]observer.Endpoint, len(next))
+	for _, e := range next {
+		nextByID[e.ID] = e
+	}
+
+	for id, e := range nextByID {
+		old, existed := prevByID[id]
+		switch {
+		case !existed:
+			added = append(added, e)
+		case !endpointsEqual(old, e):
+			changed = append(changed, e)
+		}
+	}
+	for id, e := range prevByID {
+		if _, stillExists := nextByID[id]; !stillExists {
+			removed = append(removed, e)
+		}
+	}
+	return added, removed, changed
+}
+
+// endpointsEqual compares two endpoints for the same ID by value rather than
+// with ==, since Details holds a map that isn't comparable.
+func endpointsEqual(a, b observer.Endpoint) bool {
+	return a.Target == b.Target && reflect.DeepEqual(a.Details, b.Details)
+}
@@ -0,0 +1,65 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package fileobserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/extension/observer"
+)
+
+func TestDiffEndpoints(t *testing.T) {
+	a := toEndpoint("job.yaml", 0, jobTarget{Endpoint: "10.0.0.5:5432", Labels: map[string]string{"env": "prod"}})
+	aChanged := toEndpoint("job.yaml", 0, jobTarget{Endpoint: "10.0.0.5:5432", Labels: map[string]string{"env": "staging"}})
+	b := toEndpoint("job.yaml", 1, jobTarget{Endpoint: "10.0.0.6:5432"})
+
+	tests := []struct {
+		name        string
+		prev, next  []observer.Endpoint
+		wantAdded   []observer.Endpoint
+		wantRemoved []observer.Endpoint
+		wantChanged []observer.Endpoint
+	}{
+		{
+			name:      "all new",
+			prev:      nil,
+			next:      []observer.Endpoint{a, b},
+			wantAdded: []observer.Endpoint{a, b},
+		},
+		{
+			name:        "one removed",
+			prev:        []observer.Endpoint{a, b},
+			next:        []observer.Endpoint{a},
+			wantRemoved: []observer.Endpoint{b},
+		},
+		{
+			name:        "labels changed",
+			prev:        []observer.Endpoint{a},
+			next:        []observer.Endpoint{aChanged},
+			wantChanged: []observer.Endpoint{aChanged},
+		},
+		{
+			name: "unchanged yields no diff",
+			prev: []observer.Endpoint{a, b},
+			next: []observer.Endpoint{a, b},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			added, removed, changed := diffEndpoints(tt.prev, tt.next)
+			assert.ElementsMatch(t, tt.wantAdded, added)
+			assert.ElementsMatch(t, tt.wantRemoved, removed)
+			assert.ElementsMatch(t, tt.wantChanged, changed)
+		})
+	}
+}
+
+func TestIsJobFile(t *testing.T) {
+	assert.True(t, isJobFile("/etc/otelcol/discovery.d/postgres.yaml"))
+	assert.True(t, isJobFile("/etc/otelcol/discovery.d/postgres.yml"))
+	assert.False(t, isJobFile("/etc/otelcol/discovery.d/README.md"))
+}
@@ -0,0 +1,37 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package receivercreator // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/receivercreator"
+
+import "go.uber.org/zap"
+
+// bundleStatusReporter surfaces each enabled bundle's `status:` rules (see
+// internal/bundle) to the logs pipeline, so operators can at least see that
+// a bundle defines healthy/unhealthy conditions. Evaluating those rules
+// against a subreceiver's actual metrics/log output requires the
+// endpoint-watching runtime this package snapshot doesn't implement yet (see
+// receiver.go); until that exists, announce is the honest stand-in.
+type bundleStatusReporter struct {
+	logger *zap.Logger
+}
+
+func newBundleStatusReporter(logger *zap.Logger) *bundleStatusReporter {
+	return &bundleStatusReporter{logger: logger}
+}
+
+// announce logs once, at startup, for every receiver template that defines
+// status rules, naming the bundle and noting that live health evaluation
+// isn't wired up yet. It's not the "healthy"/"unhealthy" per-endpoint
+// reporting the bundle format is meant to drive, just proof that the rules
+// were parsed and reached a consumer.
+func (r *bundleStatusReporter) announce(templates map[string]receiverTemplate) {
+	for name, tmpl := range templates {
+		if tmpl.status.Empty() {
+			continue
+		}
+		r.logger.Info(
+			"discovery bundle defines status rules; evaluating them against live endpoint matches is not wired up yet",
+			zap.String("bundle", name),
+		)
+	}
+}
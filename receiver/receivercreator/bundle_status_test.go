@@ -0,0 +1,44 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package receivercreator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/receivercreator/internal/bundle"
+)
+
+func TestBundleStatusReporter_AnnouncesOnlyTemplatesWithStatusRules(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	reporter := newBundleStatusReporter(zap.New(core))
+
+	templates := map[string]receiverTemplate{
+		"redis": {receiverConfig: receiverConfig{
+			status: bundle.StatusConfig{Metrics: map[string]bundle.StatusRule{"up": {Status: "healthy"}}},
+		}},
+		"prometheus_simple": {receiverConfig: receiverConfig{}},
+	}
+
+	reporter.announce(templates)
+
+	assert.Equal(t, 1, logs.Len())
+	entry := logs.All()[0]
+	assert.Contains(t, entry.Message, "status rules")
+	assert.Equal(t, "redis", entry.ContextMap()["bundle"])
+}
+
+func TestBundleStatusReporter_AnnounceIsNoopWithoutStatusRules(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	reporter := newBundleStatusReporter(zap.New(core))
+
+	reporter.announce(map[string]receiverTemplate{
+		"prometheus_simple": {receiverConfig: receiverConfig{}},
+	})
+
+	assert.Equal(t, 0, logs.Len())
+}
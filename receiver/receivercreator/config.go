@@ -0,0 +1,283 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package receivercreator // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/receivercreator"
+
+import (
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
+	"go.uber.org/zap"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/receivercreator/internal/bundle"
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/receivercreator/internal/properties"
+)
+
+// userConfigMap is an arbitrary map of string keys to arbitrary values as
+// specified in the user's yaml config.
+type userConfigMap map[string]any
+
+// endpointConfigKey is the key name used to represent the endpoint in a
+// subreceiver config.
+const endpointConfigKey = "endpoint"
+
+// tmpSetEndpointConfigKey is a sentinel key added to a discovered config map
+// to mark that its endpoint field was synthesized by the receivercreator and
+// should be removed once merging is done.
+const tmpSetEndpointConfigKey = "<tmp set endpoint>"
+
+// tmpEndpointLabelsConfigKey is a sentinel key carrying the observer-provided
+// labels for the endpoint this discovered config map was synthesized from.
+// It never reaches the merged subreceiver config; mergeTemplatedAndDiscoveredConfigs
+// pulls it out to build the EndpointInfo passed to secret resolution and
+// ConfigValidator.
+const tmpEndpointLabelsConfigKey = "<tmp endpoint labels>"
+
+// receiverConfig describes a receiver instance with a default config.
+type receiverConfig struct {
+	rawOwnerName string
+
+	// id is the full name of the receiver used to refer to it.
+	id component.ID
+
+	// config is the map configured by the user in the config file. It is the
+	// contents of the map from the "config" section associated with the
+	// receiver. It will not contain rule or resource_attributes related configuration.
+	config userConfigMap
+
+	// resourceAttributes allow specifying extra attributes to associate with
+	// the resource during translation.
+	resourceAttributes userConfigMap
+
+	// status is the bundle's optional `status:` section (see
+	// internal/bundle), carried through so bundleStatusReporter can surface
+	// it. Zero-valued for hand-authored (non-bundle) templates.
+	status bundle.StatusConfig
+}
+
+// receiverTemplate is the configuration of a single subreceiver inside
+// `receivers:`, combining the user's yaml and the rule used to match it
+// against discovered endpoints.
+type receiverTemplate struct {
+	receiverConfig
+
+	// Rule is the discovery rule that when matched with a discovered endpoint,
+	// will create a receiver instance based on receiverTemplate.
+	Rule               string            `mapstructure:"rule"`
+	ResourceAttributes map[string]string `mapstructure:"resource_attributes"`
+}
+
+// Config defines configuration for receiver_creator.
+type Config struct {
+	// receiverTemplates is a map of receiver id to a receiverTemplate, which
+	// is a set of receiver configs with a given type that can have multiple
+	// instances that will be created based on discovered endpoints that
+	// match the relevant rule.
+	receiverTemplates map[string]receiverTemplate
+
+	// WatchObservers defines what observer extensions to use for
+	// subreceiver discovery.
+	WatchObservers []component.ID `mapstructure:"watch_observers"`
+
+	// Enabled lists the names of embedded discovery bundles (see
+	// internal/bundle) to merge into receiverTemplates at startup, e.g.
+	// ["redis", "postgresql", "nginx"]. A bundle is skipped if the user has
+	// already defined a receiver template with the same name.
+	Enabled []string `mapstructure:"enabled"`
+
+	ResourceAttributes userConfigMap `mapstructure:"resource_attributes"`
+
+	// propertyWarnings collects issues found while applying discovery
+	// properties, e.g. a property targeting a receiver type that isn't
+	// enabled. Unmarshal has no logger to report them through, so they're
+	// logged once the receiver starts; see logPropertyWarnings.
+	propertyWarnings []string
+}
+
+var _ confmap.Unmarshaler = (*Config)(nil)
+
+// Unmarshal dynamically creates a set of receiver templates from the
+// "receivers" section of the config, then merges in any enabled discovery
+// bundles whose names don't collide with a user-defined template, then
+// overlays any discovery properties supplied via env var or CLI file.
+func (cfg *Config) Unmarshal(componentParser *confmap.Conf) error {
+	if componentParser == nil {
+		return nil
+	}
+	if err := componentParser.Unmarshal(cfg); err != nil {
+		return fmt.Errorf("failed to unmarshal receiver_creator config: %w", err)
+	}
+
+	cfg.receiverTemplates = map[string]receiverTemplate{}
+	receiversCfg, err := componentParser.Sub("receivers")
+	if err != nil {
+		return err
+	}
+	for subName := range receiversCfg.ToStringMap() {
+		subReceiverCfg, err := receiversCfg.Sub(subName)
+		if err != nil {
+			return fmt.Errorf("unable to get subreceiver config for %q: %w", subName, err)
+		}
+
+		var cfgTemplate receiverTemplate
+		if err = subReceiverCfg.Unmarshal(&cfgTemplate); err != nil {
+			return fmt.Errorf("failed to unmarshal subreceiver config for %q: %w", subName, err)
+		}
+		cfgTemplate.id, err = component.NewIDFromString(subName)
+		if err != nil {
+			return fmt.Errorf("subreceiver key %q is not a valid component id: %w", subName, err)
+		}
+		cfgTemplate.rawOwnerName = subName
+
+		userConf, err := subReceiverCfg.Sub("config")
+		if err != nil {
+			return fmt.Errorf("unable to extract config section for %q: %w", subName, err)
+		}
+		cfgTemplate.config = userConf.ToStringMap()
+
+		cfg.receiverTemplates[subName] = cfgTemplate
+	}
+
+	if err := cfg.applyDiscoveryBundles(); err != nil {
+		return err
+	}
+	return cfg.applyDiscoveryProperties()
+}
+
+// applyDiscoveryProperties overlays discovery properties (see
+// internal/properties) onto the matching receiverTemplate's config, CLI file
+// taking precedence over env vars taking precedence over whatever the
+// template already set. A property whose component ID matches no
+// receiverTemplate is recorded as a warning rather than failing config load,
+// since properties are commonly shared across collector configs that don't
+// all enable the same bundles.
+func (cfg *Config) applyDiscoveryProperties() error {
+	tree, err := properties.Resolve(os.Getenv(properties.DiscoveryPropertiesFileEnv))
+	if err != nil {
+		return fmt.Errorf("failed to resolve discovery properties: %w", err)
+	}
+
+	for _, id := range tree.IDs() {
+		tmpl, ok := cfg.receiverTemplates[id]
+		if !ok {
+			cfg.propertyWarnings = append(cfg.propertyWarnings,
+				fmt.Sprintf("discovery property targets receiver %q, which is not enabled", id))
+			continue
+		}
+		if tmpl.config == nil {
+			tmpl.config = userConfigMap{}
+		}
+		mergeInto(tmpl.config, unwrapConfigOverlay(tree.For(id)))
+		cfg.receiverTemplates[id] = tmpl
+	}
+	return nil
+}
+
+// unwrapConfigOverlay flattens away a property overlay's leading "config"
+// segment, e.g. from `receiver.redis.config.password=hunter2`. tmpl.config
+// already holds the receiver's `config:` block unwrapped, so merging the
+// overlay in as-is would nest it a second time as
+// tmpl.config["config"]["password"] instead of landing on the field a real
+// receiver schema reads, tmpl.config["password"]. Properties with no
+// "config" segment, e.g. `receiver.prometheus_simple.collection_interval=30s`,
+// already target tmpl.config directly and pass through unchanged.
+func unwrapConfigOverlay(overlay map[string]any) map[string]any {
+	nested, ok := overlay["config"].(map[string]any)
+	if !ok {
+		return overlay
+	}
+	flattened := make(map[string]any, len(overlay)+len(nested))
+	for k, v := range overlay {
+		if k == "config" {
+			continue
+		}
+		flattened[k] = v
+	}
+	mergeInto(flattened, nested)
+	return flattened
+}
+
+// mergeInto overlays src onto dst in place, recursing into nested maps so a
+// property targeting e.g. `config.password` doesn't clobber sibling fields
+// under `config`.
+func mergeInto(dst userConfigMap, src map[string]any) {
+	for k, v := range src {
+		if srcChild, ok := v.(map[string]any); ok {
+			dstChild, ok := dst[k].(map[string]any)
+			if !ok {
+				dstChild = map[string]any{}
+				dst[k] = dstChild
+			}
+			mergeInto(dstChild, srcChild)
+			continue
+		}
+		dst[k] = v
+	}
+}
+
+// logPropertyWarnings emits any warnings collected while applying discovery
+// properties. Called once the receiver starts and a logger becomes
+// available.
+func (cfg *Config) logPropertyWarnings(logger *zap.Logger) {
+	for _, msg := range cfg.propertyWarnings {
+		logger.Warn(msg)
+	}
+}
+
+// applyDiscoveryBundles merges every bundle named in cfg.Enabled into
+// cfg.receiverTemplates, once per configured observer its bundle is vetted
+// for, skipping any bundle whose name the user already defined a template for.
+func (cfg *Config) applyDiscoveryBundles() error {
+	if len(cfg.Enabled) == 0 {
+		return nil
+	}
+	enabled := make(map[string]struct{}, len(cfg.Enabled))
+	for _, name := range cfg.Enabled {
+		enabled[name] = struct{}{}
+	}
+
+	for _, observerID := range cfg.WatchObservers {
+		bundles, err := bundle.ForObserver(observerID.Type().String())
+		if err != nil {
+			return fmt.Errorf("failed to load discovery bundles for observer %q: %w", observerID, err)
+		}
+		for _, b := range bundles {
+			if _, want := enabled[b.Name]; !want {
+				continue
+			}
+			if _, defined := cfg.receiverTemplates[b.Name]; defined {
+				// User already hand-authored this receiver; bundle yields to it.
+				continue
+			}
+
+			tmpl, err := newReceiverTemplateFromBundle(b)
+			if err != nil {
+				return fmt.Errorf("failed to apply discovery bundle %q: %w", b.Name, err)
+			}
+			cfg.receiverTemplates[b.Name] = tmpl
+		}
+	}
+	return nil
+}
+
+func newReceiverTemplateFromBundle(b bundle.Bundle) (receiverTemplate, error) {
+	id, err := component.NewIDFromString(b.Name)
+	if err != nil {
+		return receiverTemplate{}, fmt.Errorf("bundle name %q is not a valid component id: %w", b.Name, err)
+	}
+
+	rule, _ := b.Template["rule"].(string)
+	rawConfig, _ := b.Template["config"].(map[string]any)
+
+	return receiverTemplate{
+		receiverConfig: receiverConfig{
+			rawOwnerName: b.Name,
+			id:           id,
+			config:       rawConfig,
+			status:       b.Status,
+		},
+		Rule: rule,
+	}, nil
+}
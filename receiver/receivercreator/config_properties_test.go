@@ -0,0 +1,45 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package receivercreator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyDiscoveryProperties_WarnsOnUnknownReceiver(t *testing.T) {
+	cfg := &Config{
+		receiverTemplates: map[string]receiverTemplate{
+			"redis": {receiverConfig: receiverConfig{config: userConfigMap{}}},
+		},
+	}
+
+	t.Setenv("OTEL_SPLUNK_DISCOVERY_receiver_x1_postgresql_x1_config_x1_username", "otel")
+
+	require := assert.New(t)
+	err := cfg.applyDiscoveryProperties()
+	require.NoError(err)
+	require.Len(cfg.propertyWarnings, 1)
+	require.Contains(cfg.propertyWarnings[0], "postgresql")
+}
+
+func TestApplyDiscoveryProperties_OverlaysMatchingTemplate(t *testing.T) {
+	cfg := &Config{
+		receiverTemplates: map[string]receiverTemplate{
+			"redis": {receiverConfig: receiverConfig{config: userConfigMap{"collection_interval": "60s"}}},
+		},
+	}
+
+	t.Setenv("OTEL_SPLUNK_DISCOVERY_receiver_x1_redis_x1_config_x1_password", "hunter2")
+
+	err := cfg.applyDiscoveryProperties()
+	assert.NoError(t, err)
+	assert.Empty(t, cfg.propertyWarnings)
+
+	redisCfg := cfg.receiverTemplates["redis"].config
+	assert.Equal(t, "60s", redisCfg["collection_interval"])
+	assert.Equal(t, "hunter2", redisCfg["password"])
+	assert.NotContains(t, redisCfg, "config", "the property's \"config\" path segment should unwrap into tmpl.config, not nest inside it")
+}
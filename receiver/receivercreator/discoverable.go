@@ -0,0 +1,88 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package receivercreator // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/receivercreator"
+
+import (
+	"net"
+	"strconv"
+	"strings"
+)
+
+// EndpointInfo carries everything about a discovered endpoint that a
+// ConfigValidator needs to decide whether a receiver's merged config
+// correctly incorporated it.
+type EndpointInfo struct {
+	// Endpoint is the full discovered endpoint string, e.g. "10.1.2.3:8080".
+	Endpoint string
+	// Host and Port are the resolved components of Endpoint, when known.
+	Host string
+	Port uint16
+	// Labels are the observer-provided labels/annotations for the endpoint.
+	Labels map[string]string
+}
+
+// newEndpointInfo builds an EndpointInfo from the raw discovered endpoint
+// string and its observer-provided labels, splitting host and port out of
+// endpoint when it's in "host:port" form. A hostless or portless endpoint
+// (e.g. a bare path from the file observer) leaves Host/Port zero-valued
+// rather than failing; callers only need best-effort values for validation.
+func newEndpointInfo(endpoint string, labels map[string]string) EndpointInfo {
+	info := EndpointInfo{Endpoint: endpoint, Labels: labels}
+	host, portStr, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		return info
+	}
+	info.Host = host
+	if port, err := strconv.ParseUint(portStr, 10, 16); err == nil {
+		info.Port = uint16(port)
+	}
+	return info
+}
+
+// ValidationIssue is a single error or warning raised against a specific
+// location in the merged raw config, pointed to by a JSON-pointer-style
+// path, e.g. "/config/scrape_configs/0/static_configs/0/targets/1".
+type ValidationIssue struct {
+	Path    string
+	Message string
+}
+
+func (i ValidationIssue) String() string {
+	return i.Path + ": " + i.Message
+}
+
+// ValidationReport is the result of validating how a discovered endpoint was
+// merged into a receiver's config, surfacing every issue found rather than
+// failing on the first one.
+type ValidationReport struct {
+	Errors   []ValidationIssue
+	Warnings []ValidationIssue
+}
+
+// HasErrors reports whether the report contains at least one error. A report
+// with only warnings does not block the merge.
+func (r ValidationReport) HasErrors() bool {
+	return len(r.Errors) > 0
+}
+
+// Error renders every error in the report as a single message, satisfying
+// the error interface so callers can wrap it directly.
+func (r ValidationReport) Error() string {
+	msgs := make([]string, len(r.Errors))
+	for i, e := range r.Errors {
+		msgs[i] = e.String()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// ConfigValidator is an optional interface a receiver's Config can implement
+// to validate how a discovered endpoint was incorporated into its merged
+// configuration. It supersedes a boolean validate/fail hook: a single
+// Validate call surfaces every offending path at once instead of failing on
+// the first issue found, so operators see the full picture in one log line.
+type ConfigValidator interface {
+	// Validate inspects the fully merged raw config for a single subreceiver
+	// instance against the discovered endpoint it was created for.
+	Validate(rawCfg map[string]any, discovered EndpointInfo) ValidationReport
+}
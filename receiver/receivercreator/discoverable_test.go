@@ -12,20 +12,26 @@ import (
 	rcvr "go.opentelemetry.io/collector/receiver"
 )
 
-// mockDiscoverableReceiver implements both receiver.Factory and Discoverable for testing
-type mockDiscoverableReceiver struct {
-	validateFunc func(rawCfg map[string]any, discoveredEndpoint string) error
+// mockValidatorReceiver implements receiver.Factory for testing, returning
+// whatever config createDefaultConfig produces.
+type mockValidatorReceiver struct {
+	createDefaultConfig func() component.Config
 }
 
-func (m *mockDiscoverableReceiver) Type() component.Type {
-	return component.MustNewType("mock_discoverable")
+// Asserted here, rather than left to whichever test happens to construct one
+// first, so a mismatched method set fails the build instead of surfacing as
+// a confusing "cannot assign" error on a field of the same name.
+var _ rcvr.Factory = (*mockValidatorReceiver)(nil)
+
+func (m *mockValidatorReceiver) Type() component.Type {
+	return component.MustNewType("mock_validator")
 }
 
-func (m *mockDiscoverableReceiver) CreateDefaultConfig() component.Config {
-	return &mockDiscoverableConfig{}
+func (m *mockValidatorReceiver) CreateDefaultConfig() component.Config {
+	return m.createDefaultConfig()
 }
 
-func (m *mockDiscoverableReceiver) CreateLogsReceiver(
+func (m *mockValidatorReceiver) CreateLogsReceiver(
 	ctx component.Config,
 	set rcvr.Settings,
 	nextConsumer component.Component,
@@ -33,7 +39,7 @@ func (m *mockDiscoverableReceiver) CreateLogsReceiver(
 	return nil, nil
 }
 
-func (m *mockDiscoverableReceiver) CreateMetricsReceiver(
+func (m *mockValidatorReceiver) CreateMetricsReceiver(
 	ctx component.Config,
 	set rcvr.Settings,
 	nextConsumer component.Component,
@@ -41,7 +47,7 @@ func (m *mockDiscoverableReceiver) CreateMetricsReceiver(
 	return nil, nil
 }
 
-func (m *mockDiscoverableReceiver) CreateTracesReceiver(
+func (m *mockValidatorReceiver) CreateTracesReceiver(
 	ctx component.Config,
 	set rcvr.Settings,
 	nextConsumer component.Component,
@@ -49,32 +55,32 @@ func (m *mockDiscoverableReceiver) CreateTracesReceiver(
 	return nil, nil
 }
 
-// mockDiscoverableConfig implements both component.Config and Discoverable
-type mockDiscoverableConfig struct {
-	validateFunc func(rawCfg map[string]any, discoveredEndpoint string) error
+// mockValidatorConfig implements both component.Config and ConfigValidator.
+type mockValidatorConfig struct {
+	report   ValidationReport
+	received EndpointInfo
 }
 
-func (m *mockDiscoverableConfig) Validate(rawCfg map[string]any, discoveredEndpoint string) error {
-	if m.validateFunc != nil {
-		return m.validateFunc(rawCfg, discoveredEndpoint)
-	}
-	return nil
+func (m *mockValidatorConfig) Validate(_ map[string]any, discovered EndpointInfo) ValidationReport {
+	m.received = discovered
+	return m.report
 }
 
-// mockNonDiscoverableConfig is a regular config that doesn't implement Discoverable
-type mockNonDiscoverableConfig struct{}
+// mockNonValidatorConfig is a regular config that doesn't implement
+// ConfigValidator.
+type mockNonValidatorConfig struct{}
 
-func TestMergeTemplatedAndDiscoveredConfigs_WithDiscoverableReceiver(t *testing.T) {
+func TestMergeTemplatedAndDiscoveredConfigs_WithConfigValidator(t *testing.T) {
 	tests := []struct {
 		name             string
 		templated        userConfigMap
 		discovered       userConfigMap
-		validateFunc     func(rawCfg map[string]any, discoveredEndpoint string) error
+		report           ValidationReport
 		expectedEndpoint string
 		expectError      bool
 	}{
 		{
-			name: "successful discoverable validation",
+			name: "successful validation, endpoint substituted",
 			templated: userConfigMap{
 				"job_name": "test-job",
 				"config": map[string]any{
@@ -83,7 +89,29 @@ func TestMergeTemplatedAndDiscoveredConfigs_WithDiscoverableReceiver(t *testing.
 							"job_name": "discovered-app",
 							"static_configs": []any{
 								map[string]any{
-									"targets": []any{"`endpoint`"},
+									"targets": []any{"10.1.2.3:8080"},
+								},
+							},
+						},
+					},
+				},
+			},
+			discovered: userConfigMap{
+				endpointConfigKey:       "10.1.2.3:8080",
+				tmpSetEndpointConfigKey: struct{}{},
+			},
+			expectedEndpoint: "10.1.2.3:8080",
+		},
+		{
+			name: "validator reports an error",
+			templated: userConfigMap{
+				"config": map[string]any{
+					"scrape_configs": []any{
+						map[string]any{
+							"job_name": "discovered-app",
+							"static_configs": []any{
+								map[string]any{
+									"targets": []any{"10.1.2.3:8080"},
 								},
 							},
 						},
@@ -91,18 +119,17 @@ func TestMergeTemplatedAndDiscoveredConfigs_WithDiscoverableReceiver(t *testing.
 				},
 			},
 			discovered: userConfigMap{
-				endpointConfigKey:          "10.1.2.3:8080",
-				tmpSetEndpointConfigKey:    struct{}{},
+				endpointConfigKey:       "10.1.2.3:8080",
+				tmpSetEndpointConfigKey: struct{}{},
 			},
-			validateFunc: func(rawCfg map[string]any, discoveredEndpoint string) error {
-				// Mock successful validation
-				return nil
+			report: ValidationReport{
+				Errors: []ValidationIssue{{Path: "/config/scrape_configs/0/job_name", Message: `must not be "discovered-app"`}},
 			},
 			expectedEndpoint: "10.1.2.3:8080",
-			expectError:      false,
+			expectError:      true,
 		},
 		{
-			name: "failed discoverable validation",
+			name: "hardcoded target never substitutes the discovered endpoint",
 			templated: userConfigMap{
 				"config": map[string]any{
 					"scrape_configs": []any{
@@ -118,11 +145,8 @@ func TestMergeTemplatedAndDiscoveredConfigs_WithDiscoverableReceiver(t *testing.
 				},
 			},
 			discovered: userConfigMap{
-				endpointConfigKey:          "10.1.2.3:8080",
-				tmpSetEndpointConfigKey:    struct{}{},
-			},
-			validateFunc: func(rawCfg map[string]any, discoveredEndpoint string) error {
-				return assert.AnError // Mock validation failure
+				endpointConfigKey:       "10.1.2.3:8080",
+				tmpSetEndpointConfigKey: struct{}{},
 			},
 			expectedEndpoint: "10.1.2.3:8080",
 			expectError:      true,
@@ -131,15 +155,13 @@ func TestMergeTemplatedAndDiscoveredConfigs_WithDiscoverableReceiver(t *testing.
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			// Create mock factory that returns discoverable config
-			factory := &mockDiscoverableReceiver{}
-			factory.CreateDefaultConfig = func() component.Config {
-				return &mockDiscoverableConfig{
-					validateFunc: tt.validateFunc,
-				}
+			factory := &mockValidatorReceiver{
+				createDefaultConfig: func() component.Config {
+					return &mockValidatorConfig{report: tt.report}
+				},
 			}
 
-			result, endpoint, err := mergeTemplatedAndDiscoveredConfigs(factory, tt.templated, tt.discovered)
+			result, endpoint, err := mergeTemplatedAndDiscoveredConfigs(factory, tt.templated, tt.discovered, nil)
 
 			if tt.expectError {
 				require.Error(t, err)
@@ -147,11 +169,10 @@ func TestMergeTemplatedAndDiscoveredConfigs_WithDiscoverableReceiver(t *testing.
 			} else {
 				require.NoError(t, err)
 				require.NotNil(t, result)
-				
-				// Verify endpoint was not injected for discoverable receivers
+
 				resultMap := result.ToStringMap()
 				_, hasEndpoint := resultMap[endpointConfigKey]
-				assert.False(t, hasEndpoint, "Discoverable receivers should not have endpoint field injected")
+				assert.False(t, hasEndpoint, "ConfigValidator receivers should not have endpoint field injected")
 			}
 
 			assert.Equal(t, tt.expectedEndpoint, endpoint)
@@ -159,11 +180,37 @@ func TestMergeTemplatedAndDiscoveredConfigs_WithDiscoverableReceiver(t *testing.
 	}
 }
 
-func TestMergeTemplatedAndDiscoveredConfigs_WithNonDiscoverableReceiver(t *testing.T) {
-	// Create mock factory that returns non-discoverable config
-	factory := &mockDiscoverableReceiver{}
-	factory.CreateDefaultConfig = func() component.Config {
-		return &mockNonDiscoverableConfig{}
+func TestMergeTemplatedAndDiscoveredConfigs_PassesResolvedHostPortAndLabels(t *testing.T) {
+	validatorCfg := &mockValidatorConfig{}
+	factory := &mockValidatorReceiver{
+		createDefaultConfig: func() component.Config {
+			return validatorCfg
+		},
+	}
+
+	templated := userConfigMap{}
+	discovered := userConfigMap{
+		endpointConfigKey:       "10.1.2.3:8080",
+		tmpSetEndpointConfigKey: struct{}{},
+		tmpEndpointLabelsConfigKey: map[string]string{
+			"namespace": "prod",
+		},
+	}
+
+	_, _, err := mergeTemplatedAndDiscoveredConfigs(factory, templated, discovered, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "10.1.2.3:8080", validatorCfg.received.Endpoint)
+	assert.Equal(t, "10.1.2.3", validatorCfg.received.Host)
+	assert.Equal(t, uint16(8080), validatorCfg.received.Port)
+	assert.Equal(t, map[string]string{"namespace": "prod"}, validatorCfg.received.Labels)
+}
+
+func TestMergeTemplatedAndDiscoveredConfigs_WithNonValidatorReceiver(t *testing.T) {
+	factory := &mockValidatorReceiver{
+		createDefaultConfig: func() component.Config {
+			return &mockNonValidatorConfig{}
+		},
 	}
 
 	templated := userConfigMap{
@@ -174,14 +221,21 @@ func TestMergeTemplatedAndDiscoveredConfigs_WithNonDiscoverableReceiver(t *testi
 		tmpSetEndpointConfigKey: struct{}{},
 	}
 
-	result, endpoint, err := mergeTemplatedAndDiscoveredConfigs(factory, templated, discovered)
+	result, endpoint, err := mergeTemplatedAndDiscoveredConfigs(factory, templated, discovered, nil)
 
 	require.NoError(t, err)
 	require.NotNil(t, result)
 	assert.Equal(t, "10.1.2.3:8080", endpoint)
 
-	// Verify the old behavior still works for non-discoverable receivers
-	// (endpoint injection logic should still run)
+	// Non-validator receivers keep the old behavior: endpoint injection still
+	// runs and substitution elsewhere in the config isn't required.
 	resultMap := result.ToStringMap()
 	assert.Equal(t, "30s", resultMap["collection_interval"])
+	assert.Equal(t, "10.1.2.3:8080", resultMap[endpointConfigKey])
+}
+
+func TestValidationReport_HasErrors(t *testing.T) {
+	assert.False(t, ValidationReport{}.HasErrors())
+	assert.False(t, ValidationReport{Warnings: []ValidationIssue{{Path: "/x", Message: "heads up"}}}.HasErrors())
+	assert.True(t, ValidationReport{Errors: []ValidationIssue{{Path: "/x", Message: "bad"}}}.HasErrors())
 }
@@ -0,0 +1,134 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package bundle embeds the vetted discovery receiver templates shipped with
+// the receivercreator, keyed by the observer extension they were written
+// against. Operators enable one by name (e.g. "redis") instead of
+// hand-authoring a `receivers:` entry with its own `rule:`/`config:` blocks.
+package bundle // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/receivercreator/internal/bundle"
+
+import (
+	"embed"
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:generate go run ./cmd/gen
+
+//go:embed bundles
+var bundleFS embed.FS
+
+const bundlesDir = "bundles"
+
+// Bundle is a single vetted receiver template plus the observer type it was
+// vetted against and the name operators enable it by.
+type Bundle struct {
+	// Name is the knob operators pass to `enabled`, e.g. "redis". It doubles
+	// as the receiver type merged into the user's `receivers` map.
+	Name string
+	// Observer is the observer type this bundle's rule is written against,
+	// e.g. "docker_observer".
+	Observer string
+	// Template is the receiver config (rule/config blocks intact), ready to
+	// be merged into the user's receivers map.
+	Template map[string]any
+	// Status describes how the receivercreator should judge whether this
+	// bundle's discovery rule is matching healthy endpoints once enabled.
+	Status StatusConfig
+}
+
+var (
+	byObserver map[string][]Bundle
+	loadErr    error
+)
+
+func init() {
+	byObserver, loadErr = load()
+}
+
+// ForObserver returns the bundles vetted against the given observer type,
+// e.g. "docker_observer". It returns the package-level load error, if any,
+// so callers see a single actionable error instead of silently getting no
+// bundles.
+func ForObserver(observerType string) ([]Bundle, error) {
+	if loadErr != nil {
+		return nil, fmt.Errorf("bundle: %w", loadErr)
+	}
+	return byObserver[observerType], nil
+}
+
+// Names returns every embedded bundle name, deduplicated and sorted.
+func Names() []string {
+	seen := map[string]struct{}{}
+	for _, bundles := range byObserver {
+		for _, b := range bundles {
+			seen[b.Name] = struct{}{}
+		}
+	}
+	names := make([]string, 0, len(seen))
+	for n := range seen {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func load() (map[string][]Bundle, error) {
+	observerDirs, err := bundleFS.ReadDir(bundlesDir)
+	if err != nil {
+		return nil, fmt.Errorf("reading embedded bundles: %w", err)
+	}
+
+	out := map[string][]Bundle{}
+	for _, observerDir := range observerDirs {
+		if !observerDir.IsDir() {
+			continue
+		}
+		observerType := strings.ReplaceAll(observerDir.Name(), "-", "_")
+		dirPath := bundlesDir + "/" + observerDir.Name()
+		files, err := bundleFS.ReadDir(dirPath)
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", dirPath, err)
+		}
+		for _, f := range files {
+			if f.IsDir() || !strings.HasSuffix(f.Name(), ".yaml.tmpl") {
+				continue
+			}
+			b, err := parseBundle(dirPath+"/"+f.Name(), observerType)
+			if err != nil {
+				return nil, err
+			}
+			b.Name = strings.TrimSuffix(f.Name(), ".yaml.tmpl")
+			out[observerType] = append(out[observerType], b)
+		}
+	}
+	return out, nil
+}
+
+func parseBundle(path, observerType string) (Bundle, error) {
+	raw, err := bundleFS.ReadFile(path)
+	if err != nil {
+		return Bundle{}, fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	var doc struct {
+		Rule   string         `yaml:"rule"`
+		Config map[string]any `yaml:"config"`
+		Status StatusConfig   `yaml:"status"`
+	}
+	if err := yaml.Unmarshal(raw, &doc); err != nil {
+		return Bundle{}, fmt.Errorf("parsing %s: %w", path, err)
+	}
+
+	return Bundle{
+		Observer: observerType,
+		Template: map[string]any{
+			"rule":   doc.Rule,
+			"config": doc.Config,
+		},
+		Status: doc.Status,
+	}, nil
+}
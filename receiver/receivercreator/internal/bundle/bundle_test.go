@@ -0,0 +1,35 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bundle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForObserver(t *testing.T) {
+	bundles, err := ForObserver("docker_observer")
+	require.NoError(t, err)
+	require.Len(t, bundles, 1)
+
+	redis := bundles[0]
+	assert.Equal(t, "redis", redis.Name)
+	assert.Equal(t, "docker_observer", redis.Observer)
+	assert.Contains(t, redis.Template, "rule")
+	assert.Contains(t, redis.Template, "config")
+	assert.False(t, redis.Status.Empty())
+}
+
+func TestForObserver_NoMatch(t *testing.T) {
+	bundles, err := ForObserver("nonexistent_observer")
+	require.NoError(t, err)
+	assert.Empty(t, bundles)
+}
+
+func TestNames(t *testing.T) {
+	names := Names()
+	assert.Equal(t, []string{"nginx", "postgresql", "redis"}, names)
+}
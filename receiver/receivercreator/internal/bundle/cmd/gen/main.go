@@ -0,0 +1,74 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Command gen renders every bundle's `.yaml.tmpl` source into a sibling
+// non-templated `.yaml` file with an example endpoint substituted in, so
+// reviewers can see what a bundle will actually produce without mentally
+// evaluating its `rule:`/`` `endpoint` `` expressions. Run via `go generate`
+// from internal/bundle.
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+const (
+	bundlesDir      = "bundles"
+	exampleEndpoint = "10.0.0.5:5432"
+	generatedHeader = "# Code generated by \"go run ./cmd/gen\"; substitutes an example endpoint for review. DO NOT hand-edit.\n"
+)
+
+var backtickExpr = regexp.MustCompile("`[^`]*`")
+
+func main() {
+	if err := run(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	entries, err := os.ReadDir(bundlesDir)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", bundlesDir, err)
+	}
+	for _, observerDir := range entries {
+		if !observerDir.IsDir() {
+			continue
+		}
+		dirPath := filepath.Join(bundlesDir, observerDir.Name())
+		files, err := os.ReadDir(dirPath)
+		if err != nil {
+			return fmt.Errorf("reading %s: %w", dirPath, err)
+		}
+		for _, f := range files {
+			if f.IsDir() || !strings.HasSuffix(f.Name(), ".yaml.tmpl") {
+				continue
+			}
+			if err := renderOne(filepath.Join(dirPath, f.Name())); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func renderOne(tmplPath string) error {
+	raw, err := os.ReadFile(tmplPath)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", tmplPath, err)
+	}
+
+	rendered := backtickExpr.ReplaceAll(raw, []byte(exampleEndpoint))
+
+	outPath := strings.TrimSuffix(tmplPath, ".tmpl")
+	out := generatedHeader + string(rendered)
+	if err := os.WriteFile(outPath, []byte(out), 0o644); err != nil {
+		return fmt.Errorf("writing %s: %w", outPath, err)
+	}
+	return nil
+}
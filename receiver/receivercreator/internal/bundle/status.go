@@ -0,0 +1,41 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package bundle // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/receivercreator/internal/bundle"
+
+// StatusConfig is the optional `status:` section of a bundle template. It
+// lets the bundle author describe, in terms of the subreceiver's own
+// telemetry, how to tell whether discovery found a healthy target.
+//
+// Metrics entries are evaluated against datapoints the subreceiver produces;
+// statements entries are evaluated against its log output (e.g. connection
+// refused errors surfaced before any metric would exist). Either can flip a
+// bundle from healthy to unhealthy; neither is required.
+type StatusConfig struct {
+	Metrics    map[string]StatusRule `yaml:"metrics"`
+	Statements map[string]StatusRule `yaml:"statements"`
+}
+
+// StatusRule is a single named condition inside a StatusConfig.
+type StatusRule struct {
+	// Status is the health this rule reports when it matches: "healthy" or
+	// "unhealthy".
+	Status string `yaml:"status"`
+	// Strict is a boolean expression evaluated against a matched metric's
+	// datapoint, e.g. "redis_up == 1".
+	Strict string `yaml:"strict"`
+	// Regexp is matched against a log record's body, e.g. a driver's
+	// connection-refused message.
+	Regexp string `yaml:"regexp"`
+	// LogRecord is the message the receivercreator emits to its internal
+	// logs pipeline when this rule matches, with `` `endpoint` `` available
+	// for substitution.
+	LogRecord string `yaml:"log_record"`
+}
+
+// Empty reports whether the bundle defined no status rules at all, meaning
+// the receivercreator should fall back to "healthy iff at least one endpoint
+// matched the rule".
+func (s StatusConfig) Empty() bool {
+	return len(s.Metrics) == 0 && len(s.Statements) == 0
+}
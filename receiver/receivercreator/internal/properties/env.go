@@ -0,0 +1,55 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package properties // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/receivercreator/internal/properties"
+
+import (
+	"os"
+	"strings"
+)
+
+// envPrefix is the prefix every discovery property environment variable
+// must carry to be picked up.
+const envPrefix = "OTEL_SPLUNK_DISCOVERY_"
+
+// decode reverses the encoding property keys need to survive shell and
+// Kubernetes env var name rules, where '.' and '/' aren't allowed:
+// "_x1_" -> ".", "_x2_" -> "/".
+func decode(encoded string) string {
+	r := strings.NewReplacer("_x1_", ".", "_x2_", "/")
+	return r.Replace(encoded)
+}
+
+// Environ loads discovery properties from the process environment.
+// Malformed OTEL_SPLUNK_DISCOVERY_* entries are skipped rather than failing
+// collector startup outright; use FromEnvironStrict to surface them.
+func Environ() *Tree {
+	tree, _ := parseEnviron(os.Environ(), false)
+	return tree
+}
+
+// FromEnvironStrict is like Environ but returns the first parse error
+// encountered instead of silently skipping it.
+func FromEnvironStrict(environ []string) (*Tree, error) {
+	return parseEnviron(environ, true)
+}
+
+func parseEnviron(environ []string, strict bool) (*Tree, error) {
+	tree := NewTree()
+	for _, kv := range environ {
+		name, value, ok := strings.Cut(kv, "=")
+		if !ok || !strings.HasPrefix(name, envPrefix) {
+			continue
+		}
+		key := decode(strings.TrimPrefix(name, envPrefix))
+		p, err := Parse(key + "=" + value)
+		if err != nil {
+			if strict {
+				return nil, err
+			}
+			continue
+		}
+		tree.Add(p)
+	}
+	return tree, nil
+}
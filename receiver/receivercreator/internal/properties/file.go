@@ -0,0 +1,57 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package properties // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/receivercreator/internal/properties"
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// FromFile parses a `--discovery-properties` file: one
+// `<component>.<type>/<name>.<field_path>=<value>` property per line, with
+// blank lines and `#`-prefixed comments ignored.
+func FromFile(path string) (*Tree, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening discovery properties file %q: %w", path, err)
+	}
+	defer f.Close()
+
+	tree := NewTree()
+	scanner := bufio.NewScanner(f)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		p, err := Parse(line)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, lineNum, err)
+		}
+		tree.Add(p)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading discovery properties file %q: %w", path, err)
+	}
+	return tree, nil
+}
+
+// Resolve builds the final property tree to apply to templated discovery
+// configs, in precedence order: a CLI properties file overrides env vars,
+// which override whatever the bundle or user template already set.
+// cliPath may be empty, in which case only env vars apply.
+func Resolve(cliPath string) (*Tree, error) {
+	tree := Environ()
+	if cliPath == "" {
+		return tree, nil
+	}
+	cli, err := FromFile(cliPath)
+	if err != nil {
+		return nil, err
+	}
+	tree.Merge(cli)
+	return tree, nil
+}
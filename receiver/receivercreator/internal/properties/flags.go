@@ -0,0 +1,25 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package properties // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/receivercreator/internal/properties"
+
+import "flag"
+
+// DiscoveryPropertiesFlag is the CLI flag name collector distributions
+// should register so operators can point at a properties file, e.g.
+// `--discovery-properties=/etc/otelcol/discovery-properties.conf`. The
+// receivercreator reads its resolved value back via
+// DiscoveryPropertiesFileEnv, the env var distributions are expected to set
+// from the parsed flag before the collector's confmap providers run.
+const DiscoveryPropertiesFlag = "discovery-properties"
+
+// DiscoveryPropertiesFileEnv is the environment variable the receivercreator
+// consults for the path a `--discovery-properties` flag resolved to.
+const DiscoveryPropertiesFileEnv = "OTEL_DISCOVERY_PROPERTIES_FILE"
+
+// RegisterFlags adds --discovery-properties to fs, returning the bound path
+// so callers can read it once fs.Parse has run.
+func RegisterFlags(fs *flag.FlagSet) *string {
+	return fs.String(DiscoveryPropertiesFlag, "",
+		"path to a discovery properties file overriding templated discovery receiver configs")
+}
@@ -0,0 +1,70 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+// Package properties lets operators override fields inside templated
+// discovery receiver configs from outside the collector's yaml, using the
+// grammar `<component>.<type>/<name>.<field_path>=<value>`, e.g.
+// `receiver.prometheus_simple.collection_interval=30s` or
+// `receiver.redis.config.password=$REDIS_PASS`. This turns bundled or
+// hand-authored discovery templates into parameterizable units without
+// forking them.
+package properties // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/receivercreator/internal/properties"
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Property is a single parsed override.
+type Property struct {
+	// Component is the kind of component targeted, e.g. "receiver". Only
+	// "receiver" is meaningful to the receivercreator today.
+	Component string
+	// Type is the receiver type, e.g. "redis".
+	Type string
+	// Name is the receiver instance name, e.g. "" for a bundle-derived
+	// receiver keyed only by type, or "primary" for `redis/primary`.
+	Name string
+	// Path is the dotted field path within the receiver's config, e.g.
+	// ["config", "password"].
+	Path []string
+	// Value is the raw override value; type coercion (string -> duration,
+	// int, etc.) is left to confmap when the overlay is merged in.
+	Value string
+}
+
+// ID returns the component.ID string this property targets, e.g. "redis" or
+// "redis/primary".
+func (p Property) ID() string {
+	if p.Name == "" {
+		return p.Type
+	}
+	return p.Type + "/" + p.Name
+}
+
+// Parse parses a single `<component>.<type>/<name>.<field_path>=<value>`
+// line into a Property.
+func Parse(line string) (Property, error) {
+	key, value, ok := strings.Cut(line, "=")
+	if !ok {
+		return Property{}, fmt.Errorf("discovery property %q: missing '='", line)
+	}
+
+	parts := strings.Split(key, ".")
+	if len(parts) < 3 {
+		return Property{}, fmt.Errorf("discovery property %q: expected <component>.<type>/<name>.<field_path>", line)
+	}
+
+	typ, name, _ := strings.Cut(parts[1], "/")
+	if typ == "" {
+		return Property{}, fmt.Errorf("discovery property %q: empty component type", line)
+	}
+
+	return Property{
+		Component: parts[0],
+		Type:      typ,
+		Name:      name,
+		Path:      parts[2:],
+		Value:     value,
+	}, nil
+}
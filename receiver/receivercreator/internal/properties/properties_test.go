@@ -0,0 +1,81 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package properties
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name    string
+		line    string
+		want    Property
+		wantErr bool
+	}{
+		{
+			name: "typed receiver, top-level field",
+			line: "receiver.prometheus_simple.collection_interval=30s",
+			want: Property{Component: "receiver", Type: "prometheus_simple", Path: []string{"collection_interval"}, Value: "30s"},
+		},
+		{
+			name: "named instance, nested field",
+			line: "receiver.redis/primary.config.password=hunter2",
+			want: Property{Component: "receiver", Type: "redis", Name: "primary", Path: []string{"config", "password"}, Value: "hunter2"},
+		},
+		{
+			name:    "missing equals",
+			line:    "receiver.redis.config.password",
+			wantErr: true,
+		},
+		{
+			name:    "too few path segments",
+			line:    "receiver.redis=foo",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.line)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPropertyID(t *testing.T) {
+	assert.Equal(t, "redis", Property{Type: "redis"}.ID())
+	assert.Equal(t, "redis/primary", Property{Type: "redis", Name: "primary"}.ID())
+}
+
+func TestTreeMergePrecedence(t *testing.T) {
+	base := NewTree()
+	base.Add(Property{Type: "redis", Path: []string{"config", "password"}, Value: "from-env"})
+	base.Add(Property{Type: "redis", Path: []string{"collection_interval"}, Value: "10s"})
+
+	higher := NewTree()
+	higher.Add(Property{Type: "redis", Path: []string{"config", "password"}, Value: "from-cli"})
+
+	base.Merge(higher)
+
+	overlay := base.For("redis")
+	assert.Equal(t, "10s", overlay["collection_interval"])
+	assert.Equal(t, "from-cli", overlay["config"].(map[string]any)["password"])
+}
+
+func TestFromEnvironDecodesKeys(t *testing.T) {
+	tree, err := FromEnvironStrict([]string{
+		"OTEL_SPLUNK_DISCOVERY_receiver_x1_redis_x1_config_x1_password=hunter2",
+		"UNRELATED=ignored",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "hunter2", tree.For("redis")["config"].(map[string]any)["password"])
+}
@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package properties // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/receivercreator/internal/properties"
+
+import "sort"
+
+// Tree is a sparse set of property overrides, keyed by the receiver
+// component ID they target (e.g. "redis" or "redis/primary"). Each value is
+// a userConfigMap-shaped overlay meant to be merged on top of that
+// receiver's templated config.
+type Tree struct {
+	byID map[string]map[string]any
+}
+
+// NewTree returns an empty Tree.
+func NewTree() *Tree {
+	return &Tree{byID: map[string]map[string]any{}}
+}
+
+// Add inserts a single property into the tree, overwriting any value already
+// set at the same path for the same component ID.
+func (t *Tree) Add(p Property) {
+	overlay, ok := t.byID[p.ID()]
+	if !ok {
+		overlay = map[string]any{}
+		t.byID[p.ID()] = overlay
+	}
+	setPath(overlay, p.Path, p.Value)
+}
+
+func setPath(m map[string]any, path []string, value string) {
+	for _, segment := range path[:len(path)-1] {
+		next, ok := m[segment].(map[string]any)
+		if !ok {
+			next = map[string]any{}
+			m[segment] = next
+		}
+		m = next
+	}
+	m[path[len(path)-1]] = value
+}
+
+// For returns the overlay targeting the given receiver component ID, or nil
+// if no property targets it.
+func (t *Tree) For(id string) map[string]any {
+	return t.byID[id]
+}
+
+// IDs returns every component ID with at least one property, sorted.
+func (t *Tree) IDs() []string {
+	ids := make([]string, 0, len(t.byID))
+	for id := range t.byID {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// Merge overlays higher's properties on top of t, mutating t in place so
+// that higher's values win on conflict. Callers apply precedence by merging
+// lowest to highest, e.g.: tree := Environ(); tree.Merge(cli).
+func (t *Tree) Merge(higher *Tree) {
+	if higher == nil {
+		return
+	}
+	for id, overlay := range higher.byID {
+		existing, ok := t.byID[id]
+		if !ok {
+			t.byID[id] = overlay
+			continue
+		}
+		mergeMaps(existing, overlay)
+	}
+}
+
+func mergeMaps(dst, src map[string]any) {
+	for k, v := range src {
+		if srcChild, ok := v.(map[string]any); ok {
+			if dstChild, ok := dst[k].(map[string]any); ok {
+				mergeMaps(dstChild, srcChild)
+				continue
+			}
+		}
+		dst[k] = v
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package receivercreator // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/receivercreator"
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/collector/confmap"
+	rcvr "go.opentelemetry.io/collector/receiver"
+)
+
+// mergeTemplatedAndDiscoveredConfigs combines a subreceiver's templated user
+// config with the endpoint-specific config synthesized from discovery,
+// returning the merged config, the endpoint it applies to, and an error if
+// the receiver implements ConfigValidator and rejects the merged result, or
+// if the discovered endpoint was never substituted anywhere in it. secrets
+// may be nil, in which case any `${scheme:uri}` config source references in
+// the template are left untouched for the collector to have already
+// resolved at startup; non-nil, it re-resolves them against this specific
+// endpoint before validation runs.
+func mergeTemplatedAndDiscoveredConfigs(factory rcvr.Factory, templated, discovered userConfigMap, secrets *secretResolver) (*confmap.Conf, string, error) {
+	discoveredEndpoint, _ := discovered[endpointConfigKey].(string)
+	discoveredLabels, _ := discovered[tmpEndpointLabelsConfigKey].(map[string]string)
+	info := newEndpointInfo(discoveredEndpoint, discoveredLabels)
+
+	toMerge := make(userConfigMap, len(discovered))
+	for k, v := range discovered {
+		if k == tmpEndpointLabelsConfigKey {
+			continue
+		}
+		toMerge[k] = v
+	}
+
+	merged := confmap.NewFromStringMap(map[string]any(templated))
+	if err := merged.Merge(confmap.NewFromStringMap(map[string]any(toMerge))); err != nil {
+		return nil, "", fmt.Errorf("failed to merge discovered config into template: %w", err)
+	}
+
+	_, injectedEndpoint := discovered[tmpSetEndpointConfigKey]
+
+	rawMerged, err := secrets.resolve(context.Background(), string(factory.Type()), merged.ToStringMap(), info)
+	if err != nil {
+		return nil, discoveredEndpoint, fmt.Errorf("failed to resolve config sources for %q: %w", factory.Type(), err)
+	}
+	merged = confmap.NewFromStringMap(rawMerged)
+
+	cfg := factory.CreateDefaultConfig()
+	validator, ok := cfg.(ConfigValidator)
+	if !ok {
+		return merged, discoveredEndpoint, nil
+	}
+
+	if report := validator.Validate(rawMerged, info); report.HasErrors() {
+		return nil, discoveredEndpoint, fmt.Errorf("discoverable validation failed for %q: %w", factory.Type(), report)
+	}
+
+	if discoveredEndpoint != "" && !endpointSubstituted(rawMerged, discoveredEndpoint) {
+		return nil, discoveredEndpoint, fmt.Errorf(
+			"discoverable validation failed for %q: discovered endpoint %q was not substituted anywhere in the merged config",
+			factory.Type(), discoveredEndpoint)
+	}
+
+	if injectedEndpoint {
+		// ConfigValidator receivers are expected to reference the endpoint
+		// via their own rule-templated fields, not a bare `endpoint`.
+		delete(rawMerged, endpointConfigKey)
+		merged = confmap.NewFromStringMap(rawMerged)
+	}
+
+	return merged, discoveredEndpoint, nil
+}
+
+// endpointSubstituted reports whether endpoint appears as a string value
+// anywhere in the merged config tree, i.e. that at least one `` `endpoint` ``
+// template token actually expanded to the discovered target rather than
+// silently being ignored in favor of a hardcoded value.
+func endpointSubstituted(node any, endpoint string) bool {
+	switch v := node.(type) {
+	case map[string]any:
+		for _, child := range v {
+			if endpointSubstituted(child, endpoint) {
+				return true
+			}
+		}
+	case []any:
+		for _, child := range v {
+			if endpointSubstituted(child, endpoint) {
+				return true
+			}
+		}
+	case string:
+		return v == endpoint
+	}
+	return false
+}
@@ -0,0 +1,67 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package receivercreator // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/receivercreator"
+
+import (
+	"context"
+
+	"go.opentelemetry.io/collector/component"
+	"go.opentelemetry.io/collector/confmap"
+	"go.opentelemetry.io/collector/confmap/provider/envprovider"
+	"go.uber.org/zap"
+)
+
+// receiverCreator is the running instance created from Config. Subreceiver
+// discovery and lifecycle management live in the observer/endpoint-watching
+// half of this package, which this snapshot doesn't yet implement; Start is
+// the point at which warnings collected while applying discovery properties
+// (see Config.propertyWarnings) finally have a logger to reach, and secrets
+// is the resolver that per-endpoint config merging (see
+// mergeTemplatedAndDiscoveredConfigs) will be given once that subreceiver
+// creation loop exists.
+type receiverCreator struct {
+	cfg          *Config
+	logger       *zap.Logger
+	secrets      *secretResolver
+	bundleStatus *bundleStatusReporter
+}
+
+func newReceiverCreator(cfg *Config, logger *zap.Logger) *receiverCreator {
+	r := &receiverCreator{cfg: cfg, logger: logger, bundleStatus: newBundleStatusReporter(logger)}
+	r.secrets = newSecretResolver(defaultSecretProviders(), r.restartSubreceiver)
+	return r
+}
+
+// defaultSecretProviders returns the confmap.Providers available for
+// per-endpoint secret resolution, keyed by scheme. Only the env provider
+// ships by default; schemes needing real backends (vault, k8s secrets, ...)
+// are left for a future config knob rather than hardcoded here.
+func defaultSecretProviders() map[string]confmap.Provider {
+	env := envprovider.NewFactory().Create(confmap.ProviderSettings{})
+	return map[string]confmap.Provider{env.Scheme(): env}
+}
+
+// restartSubreceiver is the onRotate hook threaded into secrets: it's called
+// when a resolved secret changes so just the affected subreceiver can be
+// restarted instead of the whole pipeline. Restarting a specific subreceiver
+// requires the lifecycle manager noted above, which doesn't exist yet, so
+// for now this only logs that a restart is owed.
+func (r *receiverCreator) restartSubreceiver(id string) {
+	r.logger.Info("discovery secret rotated, subreceiver restart required", zap.String("receiver", id))
+}
+
+func (r *receiverCreator) Start(_ context.Context, _ component.Host) error {
+	r.cfg.logPropertyWarnings(r.logger)
+	r.bundleStatus.announce(r.cfg.receiverTemplates)
+	return nil
+}
+
+func (r *receiverCreator) Shutdown(ctx context.Context) error {
+	for _, p := range r.secrets.providers {
+		if err := p.Shutdown(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
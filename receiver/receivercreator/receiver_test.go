@@ -0,0 +1,69 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package receivercreator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/open-telemetry/opentelemetry-collector-contrib/receiver/receivercreator/internal/bundle"
+)
+
+func TestReceiverCreator_StartLogsPropertyWarnings(t *testing.T) {
+	core, logs := observer.New(zap.WarnLevel)
+	cfg := &Config{propertyWarnings: []string{`discovery property targets receiver "postgresql", which is not enabled`}}
+
+	r := newReceiverCreator(cfg, zap.New(core))
+	assert.NoError(t, r.Start(context.Background(), nil))
+
+	assert.Equal(t, 1, logs.Len())
+	assert.Contains(t, logs.All()[0].Message, "postgresql")
+}
+
+func TestReceiverCreator_StartAnnouncesBundleStatus(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	cfg := &Config{
+		receiverTemplates: map[string]receiverTemplate{
+			"redis": {receiverConfig: receiverConfig{
+				status: bundle.StatusConfig{Metrics: map[string]bundle.StatusRule{"up": {Status: "healthy"}}},
+			}},
+		},
+	}
+
+	r := newReceiverCreator(cfg, zap.New(core))
+	assert.NoError(t, r.Start(context.Background(), nil))
+
+	assert.Equal(t, 1, logs.Len())
+	assert.Contains(t, logs.All()[0].Message, "status rules")
+}
+
+func TestReceiverCreator_SecretsWiredWithDefaultProviders(t *testing.T) {
+	r := newReceiverCreator(&Config{}, zap.NewNop())
+	require.NotNil(t, r.secrets)
+	require.Contains(t, r.secrets.providers, "env")
+
+	resolved, err := r.secrets.resolve(context.Background(), "redis", map[string]any{"password": "${env:HOME}"}, EndpointInfo{})
+	require.NoError(t, err)
+	assert.NotEqual(t, "${env:HOME}", resolved["password"])
+}
+
+func TestReceiverCreator_RestartSubreceiverLogsRotation(t *testing.T) {
+	core, logs := observer.New(zap.InfoLevel)
+	r := newReceiverCreator(&Config{}, zap.New(core))
+
+	r.restartSubreceiver("redis")
+
+	require.Equal(t, 1, logs.Len())
+	assert.Contains(t, logs.All()[0].Message, "rotated")
+}
+
+func TestReceiverCreator_ShutdownShutsDownProviders(t *testing.T) {
+	r := newReceiverCreator(&Config{}, zap.NewNop())
+	assert.NoError(t, r.Shutdown(context.Background()))
+}
@@ -0,0 +1,148 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package receivercreator // import "github.com/open-telemetry/opentelemetry-collector-contrib/receiver/receivercreator"
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"go.opentelemetry.io/collector/confmap"
+)
+
+// configSourceToken matches a single `${scheme:uri}` config source
+// reference, the same syntax the collector's own confmap providers use at
+// startup (e.g. `${env:FOO}`, `${vault:secret/db#password}`).
+var configSourceToken = regexp.MustCompile(`\$\{([a-zA-Z][a-zA-Z0-9+.-]*):([^}]*)\}`)
+
+// secretResolver re-resolves `${scheme:uri}` config source references inside
+// a templated receiver config once per newly discovered endpoint, so
+// templates can reference secrets that differ per instance, e.g.
+// `password: ${vault:secret/db#password}` or
+// `password: ${k8s:${discovered.labels.namespace}/db-creds#password}`.
+// Unlike the collector's startup-time resolution of the whole config, this
+// runs against one subreceiver's merged map at a time and exposes the
+// discovered endpoint/labels as additional selectors those URIs can embed.
+type secretResolver struct {
+	// providers are the confmap.Providers available to resolve config
+	// source references, keyed by URI scheme.
+	providers map[string]confmap.Provider
+	// onRotate is invoked with a receiver's component ID when one of its
+	// resolved secrets changes, so the caller can restart just that child
+	// receiver instead of the whole pipeline.
+	onRotate func(id string)
+}
+
+func newSecretResolver(providers map[string]confmap.Provider, onRotate func(id string)) *secretResolver {
+	return &secretResolver{providers: providers, onRotate: onRotate}
+}
+
+// resolve walks rawCfg, substituting every config source reference found in
+// a string value. discovered is exposed under the `discovered.` selector
+// prefix so a URI can embed it, e.g. `${k8s:${discovered.labels.namespace}/db-creds#password}`
+// is expanded to `${k8s:prod/db-creds#password}` before the "k8s" provider
+// is invoked.
+func (r *secretResolver) resolve(ctx context.Context, id string, rawCfg map[string]any, discovered EndpointInfo) (map[string]any, error) {
+	if r == nil || len(r.providers) == 0 {
+		return rawCfg, nil
+	}
+
+	selectors := discoveredSelectors(discovered)
+
+	resolved, err := r.resolveValue(ctx, id, rawCfg, selectors)
+	if err != nil {
+		return nil, err
+	}
+	return resolved.(map[string]any), nil
+}
+
+func (r *secretResolver) resolveValue(ctx context.Context, id string, v any, selectors map[string]string) (any, error) {
+	switch val := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(val))
+		for k, child := range val {
+			resolvedChild, err := r.resolveValue(ctx, id, child, selectors)
+			if err != nil {
+				return nil, err
+			}
+			out[k] = resolvedChild
+		}
+		return out, nil
+	case []any:
+		out := make([]any, len(val))
+		for i, child := range val {
+			resolvedChild, err := r.resolveValue(ctx, id, child, selectors)
+			if err != nil {
+				return nil, err
+			}
+			out[i] = resolvedChild
+		}
+		return out, nil
+	case string:
+		return r.resolveString(ctx, id, val, selectors)
+	default:
+		return v, nil
+	}
+}
+
+func (r *secretResolver) resolveString(ctx context.Context, id, s string, selectors map[string]string) (string, error) {
+	// Expand `${discovered.*}` placeholders first, textually, so a later
+	// `${scheme:uri}` match never has to parse nested braces.
+	s = expandSelectors(s, selectors)
+
+	var resolveErr error
+	resolved := configSourceToken.ReplaceAllStringFunc(s, func(token string) string {
+		m := configSourceToken.FindStringSubmatch(token)
+		scheme, uri := m[1], m[2]
+
+		provider, ok := r.providers[scheme]
+		if !ok {
+			return token
+		}
+
+		watcher := func(*confmap.ChangeEvent) {
+			if r.onRotate != nil {
+				r.onRotate(id)
+			}
+		}
+		retrieved, err := provider.Retrieve(ctx, scheme+":"+uri, watcher)
+		if err != nil {
+			resolveErr = fmt.Errorf("resolving %q for %q: %w", token, id, err)
+			return token
+		}
+		raw, err := retrieved.AsRaw()
+		if err != nil {
+			resolveErr = fmt.Errorf("reading resolved value of %q for %q: %w", token, id, err)
+			return token
+		}
+		return fmt.Sprintf("%v", raw)
+	})
+	if resolveErr != nil {
+		return "", resolveErr
+	}
+	return resolved, nil
+}
+
+// discoveredSelectors flattens an EndpointInfo into the set of
+// `discovered.*` placeholders a config source URI may reference.
+func discoveredSelectors(discovered EndpointInfo) map[string]string {
+	selectors := map[string]string{
+		"discovered.endpoint": discovered.Endpoint,
+		"discovered.host":     discovered.Host,
+	}
+	for k, v := range discovered.Labels {
+		selectors["discovered.labels."+k] = v
+	}
+	return selectors
+}
+
+// expandSelectors replaces every `${discovered.*}` placeholder in uri with
+// its value from selectors before the URI is handed to a provider.
+func expandSelectors(uri string, selectors map[string]string) string {
+	for key, value := range selectors {
+		uri = strings.ReplaceAll(uri, "${"+key+"}", value)
+	}
+	return uri
+}
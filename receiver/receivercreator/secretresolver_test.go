@@ -0,0 +1,87 @@
+// Copyright The OpenTelemetry Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package receivercreator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/confmap"
+)
+
+// staticProvider resolves every URI to the value it was constructed with,
+// recording each URI it was asked to retrieve.
+type staticProvider struct {
+	value string
+	seen  []string
+}
+
+func (p *staticProvider) Retrieve(_ context.Context, uri string, _ confmap.WatcherFunc) (*confmap.Retrieved, error) {
+	p.seen = append(p.seen, uri)
+	return confmap.NewRetrieved(p.value)
+}
+
+func (p *staticProvider) Scheme() string { return "static" }
+
+func (p *staticProvider) Shutdown(context.Context) error { return nil }
+
+func TestSecretResolver_Resolve(t *testing.T) {
+	provider := &staticProvider{value: "hunter2"}
+	resolver := newSecretResolver(map[string]confmap.Provider{"vault": provider}, nil)
+
+	rawCfg := map[string]any{
+		"config": map[string]any{
+			"password": "${vault:secret/db#password}",
+		},
+	}
+
+	resolved, err := resolver.resolve(context.Background(), "redis", rawCfg, EndpointInfo{Endpoint: "10.0.0.5:5432"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "hunter2", resolved["config"].(map[string]any)["password"])
+}
+
+func TestSecretResolver_ExpandsDiscoveredSelectors(t *testing.T) {
+	provider := &staticProvider{value: "hunter2"}
+	resolver := newSecretResolver(map[string]confmap.Provider{"k8s": provider}, nil)
+
+	rawCfg := map[string]any{
+		"password": "${k8s:${discovered.labels.namespace}/db-creds#password}",
+	}
+
+	_, err := resolver.resolve(context.Background(), "redis", rawCfg, EndpointInfo{
+		Labels: map[string]string{"namespace": "prod"},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, provider.seen, 1)
+	assert.Equal(t, "k8s:prod/db-creds#password", provider.seen[0])
+}
+
+func TestSecretResolver_NilResolverIsNoop(t *testing.T) {
+	var resolver *secretResolver
+	rawCfg := map[string]any{"password": "${vault:secret/db#password}"}
+
+	resolved, err := resolver.resolve(context.Background(), "redis", rawCfg, EndpointInfo{})
+	require.NoError(t, err)
+	assert.Equal(t, rawCfg, resolved)
+}
+
+func TestSecretResolver_OnRotateNotCalledDuringResolve(t *testing.T) {
+	var rotated string
+	provider := &staticProvider{value: "hunter2"}
+	resolver := newSecretResolver(map[string]confmap.Provider{"vault": provider}, func(id string) {
+		rotated = id
+	})
+
+	rawCfg := map[string]any{"password": "${vault:secret/db#password}"}
+	_, err := resolver.resolve(context.Background(), "redis", rawCfg, EndpointInfo{})
+	require.NoError(t, err)
+
+	// onRotate isn't invoked by resolve itself, only by the watcher callback
+	// a provider calls later when the underlying secret changes.
+	assert.Empty(t, rotated)
+}